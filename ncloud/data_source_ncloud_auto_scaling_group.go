@@ -0,0 +1,124 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudAutoScalingGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudAutoScalingGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_group_no": {
+				Type:        schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"auto_scaling_group_name"},
+				Description:   "The number of the auto scaling group to look up. Conflicts with auto_scaling_group_name.",
+			},
+			"auto_scaling_group_name": {
+				Type:        schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"auto_scaling_group_no"},
+				Description:   "The name of the auto scaling group to look up. Conflicts with auto_scaling_group_no.",
+			},
+			"launch_configuration_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"min_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"desired_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"health_check_type_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"load_balancer_instance_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"in_auto_scaling_group_server_instance_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	groupNo, ok := d.GetOk("auto_scaling_group_no")
+	if !ok {
+		name, nameOk := d.GetOk("auto_scaling_group_name")
+		if !nameOk {
+			return fmt.Errorf("one of auto_scaling_group_no or auto_scaling_group_name is required")
+		}
+
+		asg, err := getAutoScalingGroupByName(conn, name.(string))
+		if err != nil {
+			return err
+		}
+		if asg == nil {
+			return fmt.Errorf("no auto scaling group found with name [%s]", name.(string))
+		}
+		groupNo = *asg.AutoScalingGroupNo
+	}
+
+	asg, err := getAutoScalingGroup(conn, groupNo.(string))
+	if err != nil {
+		return err
+	}
+	if asg == nil {
+		return fmt.Errorf("no auto scaling group found with number [%s]", groupNo.(string))
+	}
+
+	d.SetId(*asg.AutoScalingGroupNo)
+	d.Set("auto_scaling_group_no", asg.AutoScalingGroupNo)
+	d.Set("auto_scaling_group_name", asg.AutoScalingGroupName)
+	d.Set("launch_configuration_no", asg.LaunchConfigurationNo)
+	d.Set("min_size", ncloudInt32Value(asg.MinSize))
+	d.Set("max_size", ncloudInt32Value(asg.MaxSize))
+	d.Set("desired_capacity", ncloudInt32Value(asg.DesiredCapacity))
+	d.Set("health_check_type_code", asg.HealthCheckTypeCode)
+	d.Set("zone_no_list", asg.ZoneNoList)
+	d.Set("load_balancer_instance_no_list", asg.LoadBalancerInstanceNoList)
+	d.Set("in_auto_scaling_group_server_instance_no_list", asg.InAutoScalingGroupServerInstanceNoList)
+
+	return nil
+}
+
+func getAutoScalingGroupByName(conn *autoscaling.APIClient, name string) (*autoscaling.AutoScalingGroup, error) {
+	reqParams := &autoscaling.GetAutoScalingGroupListRequest{}
+	resp, err := conn.V2Api.GetAutoScalingGroupListUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetAutoScalingGroupList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetAutoScalingGroupList", reqParams, resp)
+
+	for _, asg := range resp.AutoScalingGroupList {
+		if ncloudStringValue(asg.AutoScalingGroupName) == name {
+			return &asg, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,258 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go/sdk"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// loadBalancerInstanceListPageSize is the page size used while paginating
+// GetLoadBalancerInstanceList for dataSourceNcloudLoadBalancers. The filters
+// below (name_regex, network_usage_type_code, internet_line_type_code) are
+// not supported server-side, so every instance in the region has to be
+// fetched before they can be applied. tags is accepted in the schema but not
+// yet wired up; see dataSourceNcloudLoadBalancersRead.
+const loadBalancerInstanceListPageSize = 100
+
+func dataSourceNcloudLoadBalancers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudLoadBalancersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A regex string to filter load balancers by load_balancer_name.",
+			},
+			"network_usage_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"PBLIP", "PRVT"}),
+				Description:  "Filter by network usage identification code. PBLIP(PublicIP), PRVT(PrivateIP).",
+			},
+			"internet_line_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"PUBLC", "GLBL"}),
+				Description:  "Filter by internet line identification code. PUBLC(Public), GLBL(Global).",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Filter by tags attached to the load balancer instance. NOT YET IMPLEMENTED: sdk.LoadBalancerInstance does not carry tag data today, so setting this returns an error rather than silently matching nothing or everything.",
+			},
+			"load_balancers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of load balancer instances matching the filters above.",
+				Elem:        dataSourceNcloudLoadBalancerElemResource,
+			},
+		},
+	}
+}
+
+var dataSourceNcloudLoadBalancerElemResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"load_balancer_instance_no": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"load_balancer_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"virtual_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"load_balancer_algorithm_type": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"load_balancer_description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"create_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"domain_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"internet_line_type": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"load_balancer_instance_status_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"load_balancer_instance_status": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"load_balancer_instance_operation": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"network_usage_type": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"is_http_keep_alive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"connection_timeout": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"certificate_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"load_balancer_rule_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     loadBalancerRuleSchemaResource,
+		},
+		"load_balanced_server_instance_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+func dataSourceNcloudLoadBalancersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	instances, err := getAllLoadBalancerInstances(conn)
+	if err != nil {
+		return err
+	}
+
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) != 0 {
+		return fmt.Errorf("tags filtering is not yet supported: the load balancer instance list API does not return tag data")
+	}
+
+	nameRegex, hasNameRegex := d.GetOk("name_regex")
+	networkUsageTypeCode, hasNetworkUsageTypeCode := d.GetOk("network_usage_type_code")
+	internetLineTypeCode, hasInternetLineTypeCode := d.GetOk("internet_line_type_code")
+
+	var nameRe *regexp.Regexp
+	if hasNameRegex {
+		nameRe, err = regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	list := make([]map[string]interface{}, 0, len(instances))
+	for _, lb := range instances {
+		if nameRe != nil && !nameRe.MatchString(lb.LoadBalancerName) {
+			continue
+		}
+		if hasNetworkUsageTypeCode && lb.NetworkUsageType.Code != networkUsageTypeCode.(string) {
+			continue
+		}
+		if hasInternetLineTypeCode && lb.InternetLineType.Code != internetLineTypeCode.(string) {
+			continue
+		}
+
+		list = append(list, flattenLoadBalancerInstance(lb))
+	}
+
+	// This datasource has no natural identifier of its own, so derive its ID
+	// from the filter inputs and matched instance numbers instead of the
+	// current time, or every plan would see a spurious diff even when the
+	// underlying load balancer list hasn't changed.
+	idParts := []string{nameRegex.(string), networkUsageTypeCode.(string), internetLineTypeCode.(string)}
+	for _, lb := range list {
+		idParts = append(idParts, lb["load_balancer_instance_no"].(string))
+	}
+	d.SetId(fmt.Sprintf("ncloud-load-balancers-%d", hashcode.String(strings.Join(idParts, "|"))))
+	return d.Set("load_balancers", list)
+}
+
+func flattenLoadBalancerInstance(lb sdk.LoadBalancerInstance) map[string]interface{} {
+	m := map[string]interface{}{
+		"load_balancer_instance_no": lb.LoadBalancerInstanceNo,
+		"load_balancer_name":        lb.LoadBalancerName,
+		"virtual_ip":                lb.VirtualIP,
+		"load_balancer_algorithm_type": map[string]interface{}{
+			"code":      lb.LoadBalancerAlgorithmType.Code,
+			"code_name": lb.LoadBalancerAlgorithmType.CodeName,
+		},
+		"load_balancer_description": lb.LoadBalancerDescription,
+		"create_date":               lb.CreateDate,
+		"domain_name":               lb.DomainName,
+		"internet_line_type": map[string]interface{}{
+			"code":      lb.InternetLineType.Code,
+			"code_name": lb.InternetLineType.CodeName,
+		},
+		"load_balancer_instance_status_name": lb.LoadBalancerInstanceStatusName,
+		"load_balancer_instance_status": map[string]interface{}{
+			"code":      lb.LoadBalancerInstanceStatus.Code,
+			"code_name": lb.LoadBalancerInstanceStatus.CodeName,
+		},
+		"load_balancer_instance_operation": map[string]interface{}{
+			"code":      lb.LoadBalancerInstanceOperation.Code,
+			"code_name": lb.LoadBalancerInstanceOperation.CodeName,
+		},
+		"network_usage_type": map[string]interface{}{
+			"code":      lb.NetworkUsageType.Code,
+			"code_name": lb.NetworkUsageType.CodeName,
+		},
+		"is_http_keep_alive": lb.IsHTTPKeepAlive,
+		"connection_timeout": lb.ConnectionTimeout,
+		"certificate_name":   lb.CertificateName,
+	}
+
+	if len(lb.LoadBalancerRuleList) != 0 {
+		m["load_balancer_rule_list"] = getLoadBalancerRuleList(lb.LoadBalancerRuleList)
+	}
+	if len(lb.LoadBalancedServerInstanceList) != 0 {
+		m["load_balanced_server_instance_list"] = getLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList)
+	}
+
+	return m
+}
+
+// getAllLoadBalancerInstances pages through GetLoadBalancerInstanceList until
+// a short page signals there's nothing left to fetch.
+func getAllLoadBalancerInstances(conn *sdk.Conn) ([]sdk.LoadBalancerInstance, error) {
+	var all []sdk.LoadBalancerInstance
+
+	for page := 0; ; page++ {
+		reqParams := &sdk.RequestLoadBalancerInstanceList{
+			PageNo:   page,
+			PageSize: loadBalancerInstanceListPageSize,
+		}
+
+		resp, err := conn.GetLoadBalancerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
+			return nil, err
+		}
+		logCommonResponse("GetLoadBalancerInstanceList", reqParams, resp.CommonResponse)
+
+		all = append(all, resp.LoadBalancerInstanceList...)
+		if len(resp.LoadBalancerInstanceList) < loadBalancerInstanceListPageSize {
+			break
+		}
+	}
+
+	return all, nil
+}
@@ -0,0 +1,293 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudAutoScalingGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudAutoScalingGroupCreate,
+		Read:   resourceNcloudAutoScalingGroupRead,
+		Update: resourceNcloudAutoScalingGroupUpdate,
+		Delete: resourceNcloudAutoScalingGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(DefaultTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+				Description:  "Name of a auto scaling group to create. Default: Automatically specified by Ncloud.",
+			},
+			"launch_configuration_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of a launch configuration the auto scaling group will use to create new servers. The autoscaling v2 API exposes no in-place way to swap this, so changing it recreates the group.",
+			},
+			"min_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The minimum size of the auto scaling group. The autoscaling v2 API exposes no in-place way to change this, so changing it recreates the group.",
+			},
+			"max_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The maximum size of the auto scaling group. The autoscaling v2 API exposes no in-place way to change this, so changing it recreates the group.",
+			},
+			"desired_capacity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The desired capacity of the auto scaling group. Changing this value calls SetDesiredCapacity and does not recreate the group.",
+			},
+			"default_cooldown": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The amount of time, in seconds, after a scaling activity completes before another scaling activity can start. Default: 300",
+			},
+			"health_check_grace_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The amount of time, in seconds, to wait before checking the health of new servers. Default: 300",
+			},
+			"health_check_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIncludeValues([]string{"SVR", "LOAD"}),
+				Description:  "The type of health check to perform. SVR(Server), LOAD(Load balancer). Default: SVR",
+			},
+			"zone_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of zone numbers where the servers of the auto scaling group are created.",
+			},
+			"load_balancer_instance_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of load balancer instance numbers to attach to the auto scaling group. The load balancer reads back the servers the auto scaling group manages through its own load_balanced_server_instance_list.",
+			},
+			"server_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix to add in front of the server name when the server is created by the auto scaling group.",
+			},
+			"access_control_group_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of ACG numbers to apply to the servers of the auto scaling group.",
+			},
+			"in_auto_scaling_group_server_instance_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNcloudAutoScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.CreateAutoScalingGroupRequest{
+		AutoScalingGroupName:       ncloudString(d.Get("auto_scaling_group_name").(string)),
+		LaunchConfigurationNo:      ncloudString(d.Get("launch_configuration_no").(string)),
+		MinSize:                    ncloudInt32(d.Get("min_size").(int)),
+		MaxSize:                    ncloudInt32(d.Get("max_size").(int)),
+		DesiredCapacity:            ncloudInt32(d.Get("desired_capacity").(int)),
+		HealthCheckTypeCode:        ncloudString(d.Get("health_check_type_code").(string)),
+		ZoneNoList:                 StringList(d.Get("zone_no_list").([]interface{})),
+		LoadBalancerInstanceNoList: StringList(d.Get("load_balancer_instance_no_list").([]interface{})),
+		ServerNamePrefix:           ncloudString(d.Get("server_name_prefix").(string)),
+		AccessControlGroupNoList:   StringList(d.Get("access_control_group_no_list").([]interface{})),
+	}
+
+	// default_cooldown and health_check_grace_period default to 300 on the
+	// API side when omitted; 0 is also a valid explicit value, so only send
+	// them when the user actually set them.
+	if v, ok := d.GetOkExists("default_cooldown"); ok {
+		reqParams.DefaultCooldown = ncloudInt32(v.(int))
+	}
+	if v, ok := d.GetOkExists("health_check_grace_period"); ok {
+		reqParams.HealthCheckGracePeriod = ncloudInt32(v.(int))
+	}
+
+	resp, err := conn.V2Api.CreateAutoScalingGroupUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("CreateAutoScalingGroup", err, reqParams)
+		return err
+	}
+	logCommonResponse("CreateAutoScalingGroup", reqParams, resp)
+
+	asg := resp.AutoScalingGroupList[0]
+	d.SetId(*asg.AutoScalingGroupNo)
+
+	stateConf := newStateChangeConf(
+		[]string{"CREATING"},
+		[]string{"INSERVICE"},
+		autoScalingGroupStateRefreshFunc(conn, d.Id()),
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for auto scaling group (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceNcloudAutoScalingGroupRead(d, meta)
+}
+
+func resourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	asg, err := getAutoScalingGroup(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if asg == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auto_scaling_group_name", asg.AutoScalingGroupName)
+	d.Set("launch_configuration_no", asg.LaunchConfigurationNo)
+	d.Set("min_size", ncloudInt32Value(asg.MinSize))
+	d.Set("max_size", ncloudInt32Value(asg.MaxSize))
+	d.Set("desired_capacity", ncloudInt32Value(asg.DesiredCapacity))
+	d.Set("default_cooldown", ncloudInt32Value(asg.DefaultCooldown))
+	d.Set("health_check_grace_period", ncloudInt32Value(asg.HealthCheckGracePeriod))
+	d.Set("health_check_type_code", asg.HealthCheckTypeCode)
+	d.Set("zone_no_list", asg.ZoneNoList)
+	d.Set("load_balancer_instance_no_list", asg.LoadBalancerInstanceNoList)
+	d.Set("server_name_prefix", asg.ServerNamePrefix)
+	d.Set("access_control_group_no_list", asg.AccessControlGroupNoList)
+	d.Set("in_auto_scaling_group_server_instance_no_list", asg.InAutoScalingGroupServerInstanceNoList)
+
+	return nil
+}
+
+func resourceNcloudAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	if d.HasChange("desired_capacity") {
+		reqParams := &autoscaling.SetDesiredCapacityRequest{
+			AutoScalingGroupName: ncloudString(d.Get("auto_scaling_group_name").(string)),
+			DesiredCapacity:      ncloudInt32(d.Get("desired_capacity").(int)),
+		}
+
+		resp, err := conn.V2Api.SetDesiredCapacityUsingPUT(reqParams)
+		if err != nil {
+			logErrorResponse("SetDesiredCapacity", err, reqParams)
+			return err
+		}
+		logCommonResponse("SetDesiredCapacity", reqParams, resp)
+
+		stateConf := newStateChangeConf(
+			[]string{"ADJUSTING"},
+			[]string{"INSERVICE"},
+			autoScalingGroupStateRefreshFunc(conn, d.Id()),
+			d.Timeout(schema.TimeoutUpdate),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for auto scaling group (%s) desired capacity to settle: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("load_balancer_instance_no_list") {
+		reqParams := &autoscaling.SetAutoScalingGroupLoadBalancerRequest{
+			AutoScalingGroupNo:         ncloudString(d.Id()),
+			LoadBalancerInstanceNoList: StringList(d.Get("load_balancer_instance_no_list").([]interface{})),
+		}
+
+		resp, err := conn.V2Api.SetAutoScalingGroupLoadBalancerUsingPUT(reqParams)
+		if err != nil {
+			logErrorResponse("SetAutoScalingGroupLoadBalancer", err, reqParams)
+			return err
+		}
+		logCommonResponse("SetAutoScalingGroupLoadBalancer", reqParams, resp)
+	}
+
+	return resourceNcloudAutoScalingGroupRead(d, meta)
+}
+
+func resourceNcloudAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.DeleteAutoScalingGroupRequest{
+		AutoScalingGroupNo: ncloudString(d.Id()),
+	}
+	_, err := conn.V2Api.DeleteAutoScalingGroupUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteAutoScalingGroup", err, reqParams)
+		return err
+	}
+
+	stateConf := newStateChangeConf(
+		[]string{"INSERVICE", "DELETING"},
+		[]string{},
+		autoScalingGroupStateRefreshFunc(conn, d.Id()),
+		d.Timeout(schema.TimeoutDelete),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for auto scaling group (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getAutoScalingGroup(conn *autoscaling.APIClient, id string) (*autoscaling.AutoScalingGroup, error) {
+	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
+		AutoScalingGroupNoList: []string{id},
+	}
+	resp, err := conn.V2Api.GetAutoScalingGroupListUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetAutoScalingGroupList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetAutoScalingGroupList", reqParams, resp)
+
+	for _, asg := range resp.AutoScalingGroupList {
+		if *asg.AutoScalingGroupNo == id {
+			return &asg, nil
+		}
+	}
+	return nil, nil
+}
+
+// autoScalingGroupStateRefreshFunc implements resource.StateRefreshFunc for
+// the auto scaling group list API, returning the group status code ("" once
+// the group is no longer returned at all).
+func autoScalingGroupStateRefreshFunc(conn *autoscaling.APIClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		asg, err := getAutoScalingGroup(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if asg == nil {
+			return nil, "", nil
+		}
+		return asg, ncloudStringValue(asg.AutoScalingGroupStatus), nil
+	}
+}
@@ -0,0 +1,225 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudAutoScalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudAutoScalingPolicyCreate,
+		Read:   resourceNcloudAutoScalingPolicyRead,
+		Update: resourceNcloudAutoScalingPolicyUpdate,
+		Delete: resourceNcloudAutoScalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+				Description:  "Name of a policy to create. PutScalingPolicy upserts by this name, so renaming requires creating a new policy.",
+			},
+			"auto_scaling_group_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the auto scaling group the policy scales.",
+			},
+			"adjustment_type_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIncludeValues([]string{"CHANG", "EXACT", "PRCNT"}),
+				Description:  "Adjustment type code. CHANG(ChangeInCapacity), EXACT(ExactCapacity), PRCNT(PercentChangeInCapacity).",
+			},
+			"scaling_adjustment": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number to adjust the auto scaling group size by, interpreted according to adjustment_type_code. A positive value increases capacity, a negative value decreases it.",
+			},
+			"cooldown": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The amount of time, in seconds, after this scaling activity completes before any further scaling activities can start. Default: the auto scaling group's default_cooldown.",
+			},
+			"min_adjustment_step": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Used only together with adjustment_type_code PRCNT to set the minimum number of servers to add or remove.",
+			},
+			"monitoring_alarm": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        monitoringAlarmSchemaResource,
+				Description: "Monitoring metric, drawn from the existing ncloud monitoring metrics, that triggers this scaling policy.",
+			},
+			"auto_scaling_policy_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var monitoringAlarmSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"metric_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the ncloud monitoring metric to watch, e.g. avg_cpu_used_rto.",
+		},
+		"statistic": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateIncludeValues([]string{"AVG", "MAX", "MIN", "SUM"}),
+			Description:  "Statistic applied to the metric over each period. Default: AVG.",
+		},
+		"comparison_operator": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIncludeValues([]string{"GT", "GE", "LT", "LE"}),
+			Description:  "Comparison applied between the metric statistic and threshold to decide whether the alarm fires.",
+		},
+		"threshold": {
+			Type:        schema.TypeFloat,
+			Required:    true,
+			Description: "Value the metric statistic is compared against.",
+		},
+		"period": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Length, in seconds, of each evaluation period. Default: 300.",
+		},
+		"evaluation_periods": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Number of consecutive periods the comparison must hold before the alarm fires. Default: 1.",
+		},
+	},
+}
+
+func resourceNcloudAutoScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.PutScalingPolicyRequest{
+		AutoScalingPolicyName: ncloudString(d.Get("auto_scaling_policy_name").(string)),
+		AutoScalingGroupNo:    ncloudString(d.Get("auto_scaling_group_no").(string)),
+		AdjustmentTypeCode:    ncloudString(d.Get("adjustment_type_code").(string)),
+		ScalingAdjustment:     ncloudInt32(d.Get("scaling_adjustment").(int)),
+	}
+
+	// cooldown and min_adjustment_step fall back to group/API defaults when
+	// omitted; 0 is also a valid explicit value, so only send them when the
+	// user actually set them.
+	if v, ok := d.GetOkExists("cooldown"); ok {
+		reqParams.Cooldown = ncloudInt32(v.(int))
+	}
+	if v, ok := d.GetOkExists("min_adjustment_step"); ok {
+		reqParams.MinAdjustmentStep = ncloudInt32(v.(int))
+	}
+
+	if v, ok := d.GetOk("monitoring_alarm"); ok {
+		alarm := v.([]interface{})[0].(map[string]interface{})
+		reqParams.MonitoringAlarm = &autoscaling.MonitoringAlarm{
+			MetricName:         ncloudString(alarm["metric_name"].(string)),
+			Statistic:          ncloudString(alarm["statistic"].(string)),
+			ComparisonOperator: ncloudString(alarm["comparison_operator"].(string)),
+			Threshold:          ncloudFloat64(alarm["threshold"].(float64)),
+		}
+		if v, ok := alarm["period"].(int); ok && v != 0 {
+			reqParams.MonitoringAlarm.Period = ncloudInt32(v)
+		}
+		if v, ok := alarm["evaluation_periods"].(int); ok && v != 0 {
+			reqParams.MonitoringAlarm.EvaluationPeriods = ncloudInt32(v)
+		}
+	}
+
+	resp, err := conn.V2Api.PutScalingPolicyUsingPUT(reqParams)
+	if err != nil {
+		logErrorResponse("PutScalingPolicy", err, reqParams)
+		return err
+	}
+	logCommonResponse("PutScalingPolicy", reqParams, resp)
+
+	policy := resp.ScalingPolicyList[0]
+	d.SetId(*policy.AutoScalingPolicyNo)
+
+	return resourceNcloudAutoScalingPolicyRead(d, meta)
+}
+
+func resourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	policy, err := getAutoScalingPolicy(conn, d.Get("auto_scaling_group_no").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auto_scaling_policy_name", policy.AutoScalingPolicyName)
+	d.Set("adjustment_type_code", policy.AdjustmentTypeCode)
+	d.Set("scaling_adjustment", ncloudInt32Value(policy.ScalingAdjustment))
+	d.Set("cooldown", ncloudInt32Value(policy.Cooldown))
+	d.Set("min_adjustment_step", ncloudInt32Value(policy.MinAdjustmentStep))
+
+	if policy.MonitoringAlarm != nil {
+		d.Set("monitoring_alarm", []map[string]interface{}{
+			{
+				"metric_name":         ncloudStringValue(policy.MonitoringAlarm.MetricName),
+				"statistic":           ncloudStringValue(policy.MonitoringAlarm.Statistic),
+				"comparison_operator": ncloudStringValue(policy.MonitoringAlarm.ComparisonOperator),
+				"threshold":           ncloudFloat64Value(policy.MonitoringAlarm.Threshold),
+				"period":              ncloudInt32Value(policy.MonitoringAlarm.Period),
+				"evaluation_periods":  ncloudInt32Value(policy.MonitoringAlarm.EvaluationPeriods),
+			},
+		})
+	}
+
+	return nil
+}
+
+func resourceNcloudAutoScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNcloudAutoScalingPolicyCreate(d, meta)
+}
+
+func resourceNcloudAutoScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.DeletePolicyRequest{
+		AutoScalingGroupNo:  ncloudString(d.Get("auto_scaling_group_no").(string)),
+		AutoScalingPolicyNo: ncloudString(d.Id()),
+	}
+	_, err := conn.V2Api.DeletePolicyUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("DeletePolicy", err, reqParams)
+		return err
+	}
+
+	return nil
+}
+
+func getAutoScalingPolicy(conn *autoscaling.APIClient, groupNo string, id string) (*autoscaling.ScalingPolicy, error) {
+	reqParams := &autoscaling.GetScalingPolicyListRequest{
+		AutoScalingGroupNo: ncloudString(groupNo),
+	}
+	resp, err := conn.V2Api.GetScalingPolicyListUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetScalingPolicyList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetScalingPolicyList", reqParams, resp)
+
+	for _, policy := range resp.ScalingPolicyList {
+		if *policy.AutoScalingPolicyNo == id {
+			return &policy, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,168 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudAutoScalingSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudAutoScalingScheduleCreate,
+		Read:   resourceNcloudAutoScalingScheduleRead,
+		Update: resourceNcloudAutoScalingScheduleUpdate,
+		Delete: resourceNcloudAutoScalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_schedule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+				Description:  "Name of a schedule to create. PutScheduledUpdateGroupAction upserts by this name, so renaming requires creating a new schedule.",
+			},
+			"auto_scaling_group_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the auto scaling group the schedule resizes.",
+			},
+			"min_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The minimum size to set the auto scaling group to at the scheduled time.",
+			},
+			"max_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum size to set the auto scaling group to at the scheduled time.",
+			},
+			"desired_capacity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The desired capacity to set the auto scaling group to at the scheduled time.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The time, in RFC3339 format, at which the schedule takes effect.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The time, in RFC3339 format, at which the schedule expires.",
+			},
+			"recurrence": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cron expression describing when this schedule should recur.",
+			},
+			"auto_scaling_schedule_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudAutoScalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.PutScheduledUpdateGroupActionRequest{
+		AutoScalingScheduleName: ncloudString(d.Get("auto_scaling_schedule_name").(string)),
+		AutoScalingGroupNo:      ncloudString(d.Get("auto_scaling_group_no").(string)),
+		StartTime:               ncloudString(d.Get("start_time").(string)),
+		EndTime:                 ncloudString(d.Get("end_time").(string)),
+		Recurrence:              ncloudString(d.Get("recurrence").(string)),
+	}
+
+	// min_size, max_size and desired_capacity are each optional on a
+	// schedule — leaving one unset means "don't touch this dimension at the
+	// scheduled time", and 0 is a valid explicit value (e.g. scaling to zero
+	// off-hours), so only send the ones the user actually set.
+	if v, ok := d.GetOkExists("min_size"); ok {
+		reqParams.MinSize = ncloudInt32(v.(int))
+	}
+	if v, ok := d.GetOkExists("max_size"); ok {
+		reqParams.MaxSize = ncloudInt32(v.(int))
+	}
+	if v, ok := d.GetOkExists("desired_capacity"); ok {
+		reqParams.DesiredCapacity = ncloudInt32(v.(int))
+	}
+
+	resp, err := conn.V2Api.PutScheduledUpdateGroupActionUsingPUT(reqParams)
+	if err != nil {
+		logErrorResponse("PutScheduledUpdateGroupAction", err, reqParams)
+		return err
+	}
+	logCommonResponse("PutScheduledUpdateGroupAction", reqParams, resp)
+
+	schedule := resp.ScheduledUpdateGroupActionList[0]
+	d.SetId(*schedule.AutoScalingScheduleNo)
+
+	return resourceNcloudAutoScalingScheduleRead(d, meta)
+}
+
+func resourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	schedule, err := getAutoScalingSchedule(conn, d.Get("auto_scaling_group_no").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if schedule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auto_scaling_schedule_name", schedule.AutoScalingScheduleName)
+	d.Set("min_size", ncloudInt32Value(schedule.MinSize))
+	d.Set("max_size", ncloudInt32Value(schedule.MaxSize))
+	d.Set("desired_capacity", ncloudInt32Value(schedule.DesiredCapacity))
+	d.Set("start_time", schedule.StartTime)
+	d.Set("end_time", schedule.EndTime)
+	d.Set("recurrence", schedule.Recurrence)
+
+	return nil
+}
+
+func resourceNcloudAutoScalingScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNcloudAutoScalingScheduleCreate(d, meta)
+}
+
+func resourceNcloudAutoScalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.DeleteScheduledActionRequest{
+		AutoScalingGroupNo:    ncloudString(d.Get("auto_scaling_group_no").(string)),
+		AutoScalingScheduleNo: ncloudString(d.Id()),
+	}
+	_, err := conn.V2Api.DeleteScheduledActionUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteScheduledAction", err, reqParams)
+		return err
+	}
+
+	return nil
+}
+
+func getAutoScalingSchedule(conn *autoscaling.APIClient, groupNo string, id string) (*autoscaling.ScheduledUpdateGroupAction, error) {
+	reqParams := &autoscaling.GetScheduledActionsRequest{
+		AutoScalingGroupNo: ncloudString(groupNo),
+	}
+	resp, err := conn.V2Api.GetScheduledActionsUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetScheduledActions", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetScheduledActions", reqParams, resp)
+
+	for _, schedule := range resp.ScheduledUpdateGroupActionList {
+		if *schedule.AutoScalingScheduleNo == id {
+			return &schedule, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,155 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudLaunchConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudLaunchConfigurationCreate,
+		Read:   resourceNcloudLaunchConfigurationRead,
+		Delete: resourceNcloudLaunchConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"launch_configuration_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 255),
+				Description:  "Name of a launch configuration to create. Default: Automatically specified by Ncloud.",
+			},
+			"server_image_product_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Server image product code to create the launch configuration. It can be obtained through the getServerImageProductList action. You are required to select one between this parameter and memberServerImageNo.",
+			},
+			"server_product_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Server product code to create the launch configuration. It can be obtained through the getServerProductList action. Default : Selected as the minimum specification. Since depending on the value of the serverImageProductCode or memberServerImageNo parameter.",
+			},
+			"member_server_image_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Required value when manually creating a server image. It can be obtained through the getMemberServerImageInstanceList action. You are required to select one between this parameter and serverImageProductCode.",
+			},
+			"login_key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Login key name to access the server created by the launch configuration.",
+			},
+			"init_script_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Init script number to create the launch configuration. It can be obtained through the getInitScriptList action.",
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "User data to set while creating a server with the launch configuration.",
+			},
+			"launch_configuration_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.CreateLaunchConfigurationRequest{
+		LaunchConfigurationName: ncloudString(d.Get("launch_configuration_name").(string)),
+		ServerImageProductCode:  ncloudString(d.Get("server_image_product_code").(string)),
+		ServerProductCode:       ncloudString(d.Get("server_product_code").(string)),
+		MemberServerImageNo:     ncloudString(d.Get("member_server_image_no").(string)),
+		LoginKeyName:            ncloudString(d.Get("login_key_name").(string)),
+		InitScriptNo:            ncloudString(d.Get("init_script_no").(string)),
+		UserData:                ncloudString(d.Get("user_data").(string)),
+	}
+
+	resp, err := conn.V2Api.CreateLaunchConfigurationUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("CreateLaunchConfiguration", err, reqParams)
+		return err
+	}
+	logCommonResponse("CreateLaunchConfiguration", reqParams, resp)
+
+	lc := resp.LaunchConfigurationList[0]
+	d.SetId(*lc.LaunchConfigurationNo)
+
+	return resourceNcloudLaunchConfigurationRead(d, meta)
+}
+
+func resourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	lc, err := getLaunchConfiguration(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if lc == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("launch_configuration_name", lc.LaunchConfigurationName)
+	d.Set("server_image_product_code", lc.ServerImageProductCode)
+	d.Set("server_product_code", lc.ServerProductCode)
+	d.Set("member_server_image_no", lc.MemberServerImageNo)
+	d.Set("login_key_name", lc.LoginKeyName)
+	d.Set("init_script_no", lc.InitScriptNo)
+	d.Set("user_data", lc.UserData)
+
+	return nil
+}
+
+func resourceNcloudLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).scalingconn
+
+	reqParams := &autoscaling.DeleteLaunchConfigurationRequest{
+		LaunchConfigurationNo: ncloudString(d.Id()),
+	}
+	_, err := conn.V2Api.DeleteLaunchConfigurationUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteLaunchConfiguration", err, reqParams)
+		return err
+	}
+
+	return nil
+}
+
+func getLaunchConfiguration(conn *autoscaling.APIClient, id string) (*autoscaling.LaunchConfiguration, error) {
+	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
+		LaunchConfigurationNoList: []string{id},
+	}
+	resp, err := conn.V2Api.GetLaunchConfigurationListUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetLaunchConfigurationList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetLaunchConfigurationList", reqParams, resp)
+
+	for _, lc := range resp.LaunchConfigurationList {
+		if *lc.LaunchConfigurationNo == id {
+			return &lc, nil
+		}
+	}
+	return nil, nil
+}
@@ -3,10 +3,12 @@ package ncloud
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go/common"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go/sdk"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -22,6 +24,7 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(DefaultTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
 
@@ -47,8 +50,9 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 			"load_balancer_rule_list": {
 				Type:        schema.TypeList,
 				Required:    true,
+				MinItems:    1,
 				Elem:        loadBalancerRuleSchemaResource,
-				Description: "Load balancer rules are required to create a load balancer.",
+				Description: "Load balancer rules managed by this resource. A load balancer must have at least one rule, so at least one must be declared here. Additional rules can be added independently with ncloud_load_balancer_rule resources pointed at this load balancer's load_balancer_instance_no; this resource only ever reconciles the rules declared in this list and leaves rules managed elsewhere untouched.",
 			},
 			"server_instance_no_list": {
 				Type:        schema.TypeList,
@@ -59,12 +63,14 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 			"internet_line_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: validateIncludeValues([]string{"PUBLC", "GLBL"}),
 				Description:  "Internet line identification code. PUBLC(Public), GLBL(Global). default : PUBLC(Public)",
 			},
 			"network_usage_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: validateIncludeValues([]string{"PBLIP", "PRVT"}),
 				Description:  "Network usage identification code. PBLIP(PublicIP), PRVT(PrivateIP). default : PBLIP(PublicIP)",
 			},
@@ -153,9 +159,16 @@ func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{})
 	LoadBalancerInstance := &resp.LoadBalancerInstanceList[0]
 	d.SetId(LoadBalancerInstance.LoadBalancerInstanceNo)
 
-	if err := waitForLoadBalancerInstance(conn, LoadBalancerInstance.LoadBalancerInstanceNo, "USED", DefaultCreateTimeout); err != nil {
-		return err
+	stateConf := newStateChangeConf(
+		[]string{"INIT", "CREATING"},
+		[]string{"USED"},
+		loadBalancerInstanceStateRefreshFunc(conn, d.Id()),
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for load balancer instance (%s) to become ready: %s", d.Id(), err)
 	}
+
 	return resourceNcloudLoadBalancerRead(d, meta)
 }
 
@@ -197,9 +210,7 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("connection_timeout", lb.ConnectionTimeout)
 		d.Set("certificate_name", lb.CertificateName)
 
-		if len(lb.LoadBalancerRuleList) != 0 {
-			d.Set("load_balancer_rule_list", getLoadBalancerRuleList(lb.LoadBalancerRuleList))
-		}
+		d.Set("load_balancer_rule_list", getLoadBalancerRuleList(filterManagedLoadBalancerRules(d, lb.LoadBalancerRuleList)))
 		if len(lb.LoadBalancedServerInstanceList) != 0 {
 			d.Set("load_balanced_server_instance_list", getLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList))
 		} else {
@@ -223,7 +234,10 @@ func getLoadBalancerRuleList(lbRuleList []sdk.LoadBalancerRule) []interface{} {
 			"server_port":           r.ServerPort,
 			"l7_health_check_path":  r.L7HealthCheckPath,
 			"certificate_name":      r.CertificateName,
+			"sni_certificate":       flattenSniCertificateList(r.SniCertificateList),
 			"proxy_protocol_use_yn": r.ProxyProtocolUseYn,
+			"redirect":              flattenLoadBalancerRedirect(r.Redirect),
+			"sticky_session":        flattenStickySession(r.StickySession),
 		}
 		log.Printf("%#v", rule)
 		list = append(list, rule)
@@ -234,6 +248,83 @@ func getLoadBalancerRuleList(lbRuleList []sdk.LoadBalancerRule) []interface{} {
 	return list
 }
 
+func flattenSniCertificateList(sniCertificateList []sdk.SniCertificate) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(sniCertificateList))
+
+	for _, c := range sniCertificateList {
+		list = append(list, map[string]interface{}{
+			"hostname":         c.Hostname,
+			"certificate_name": c.CertificateName,
+		})
+	}
+	return list
+}
+
+func flattenLoadBalancerRedirect(redirect *sdk.LoadBalancerRedirect) []map[string]interface{} {
+	if redirect == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"enabled":            redirect.Enabled,
+			"protocol_type_code": redirect.ProtocolTypeCode,
+			"port":               redirect.Port,
+			"status_code":        redirect.StatusCode,
+		},
+	}
+}
+
+func flattenStickySession(stickySession *sdk.StickySession) []map[string]interface{} {
+	if stickySession == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"enabled":           stickySession.Enabled,
+			"cookie_name":       stickySession.CookieName,
+			"expiration_period": stickySession.ExpirationPeriod,
+		},
+	}
+}
+
+func expandSniCertificateList(sniCertificateList []interface{}) []sdk.SniCertificate {
+	list := make([]sdk.SniCertificate, 0, len(sniCertificateList))
+
+	for _, v := range sniCertificateList {
+		m := v.(map[string]interface{})
+		list = append(list, sdk.SniCertificate{
+			Hostname:        m["hostname"].(string),
+			CertificateName: m["certificate_name"].(string),
+		})
+	}
+	return list
+}
+
+func expandLoadBalancerRedirect(redirect []interface{}) *sdk.LoadBalancerRedirect {
+	if len(redirect) == 0 {
+		return nil
+	}
+	m := redirect[0].(map[string]interface{})
+	return &sdk.LoadBalancerRedirect{
+		Enabled:          m["enabled"].(bool),
+		ProtocolTypeCode: m["protocol_type_code"].(string),
+		Port:             m["port"].(int),
+		StatusCode:       m["status_code"].(int),
+	}
+}
+
+func expandStickySession(stickySession []interface{}) *sdk.StickySession {
+	if len(stickySession) == 0 {
+		return nil
+	}
+	m := stickySession[0].(map[string]interface{})
+	return &sdk.StickySession{
+		Enabled:          m["enabled"].(bool),
+		CookieName:       m["cookie_name"].(string),
+		ExpirationPeriod: m["expiration_period"].(int),
+	}
+}
+
 func getLoadBalancedServerInstanceList(loadBalancedServerInstanceList []sdk.LoadBalancedServerInstance) []string {
 	list := make([]string, 0, len(loadBalancedServerInstanceList))
 
@@ -246,13 +337,140 @@ func getLoadBalancedServerInstanceList(loadBalancedServerInstanceList []sdk.Load
 
 func resourceNcloudLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*NcloudSdk).conn
-	return deleteLoadBalancerInstance(conn, d.Id())
+	return deleteLoadBalancerInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 }
 
 func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	if d.HasChange("server_instance_no_list") {
+		reqParams := &sdk.RequestChangeLoadBalancedServerInstances{
+			LoadBalancerInstanceNo: d.Id(),
+			ServerInstanceNoList:   StringList(d.Get("server_instance_no_list").([]interface{})),
+		}
+
+		resp, err := conn.ChangeLoadBalancedServerInstances(reqParams)
+		if err != nil {
+			logErrorResponse("ChangeLoadBalancedServerInstances", err, reqParams)
+			return err
+		}
+		logCommonResponse("ChangeLoadBalancedServerInstances", reqParams, resp.CommonResponse)
+	}
+
+	if d.HasChange("load_balancer_algorithm_type_code") || d.HasChange("load_balancer_description") {
+		reqParams := &sdk.RequestChangeLoadBalancerInstanceConfiguration{
+			LoadBalancerInstanceNo:        d.Id(),
+			LoadBalancerAlgorithmTypeCode: d.Get("load_balancer_algorithm_type_code").(string),
+			LoadBalancerDescription:       d.Get("load_balancer_description").(string),
+		}
+
+		resp, err := conn.ChangeLoadBalancerInstanceConfiguration(reqParams)
+		if err != nil {
+			logErrorResponse("ChangeLoadBalancerInstanceConfiguration", err, reqParams)
+			return err
+		}
+		logCommonResponse("ChangeLoadBalancerInstanceConfiguration", reqParams, resp.CommonResponse)
+
+		stateConf := newStateChangeConf(
+			[]string{"CONFIGCG"},
+			[]string{"USED"},
+			loadBalancerInstanceStateRefreshFunc(conn, d.Id()),
+			d.Timeout(schema.TimeoutUpdate),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for load balancer instance (%s) configuration change: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("load_balancer_rule_list") {
+		o, n := d.GetChange("load_balancer_rule_list")
+		if err := reconcileLoadBalancerRules(conn, d.Id(), o.([]interface{}), n.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudLoadBalancerRead(d, meta)
 }
 
+// filterManagedLoadBalancerRules restricts the rules read back from the API
+// to the ones this resource's load_balancer_rule_list is currently tracking
+// in state. Without this, a rule created out-of-band by
+// resource_ncloud_load_balancer_rule would get absorbed into this resource's
+// state on the next Read and then get deleted by reconcileLoadBalancerRules
+// on the following apply, since it wouldn't be present in the new config.
+func filterManagedLoadBalancerRules(d *schema.ResourceData, all []sdk.LoadBalancerRule) []sdk.LoadBalancerRule {
+	managed := make(map[string]bool)
+	for _, v := range d.Get("load_balancer_rule_list").([]interface{}) {
+		rule := expandLoadBalancerRule(v.(map[string]interface{}))
+		managed[loadBalancerRuleKey(rule)] = true
+	}
+
+	filtered := make([]sdk.LoadBalancerRule, 0, len(all))
+	for _, r := range all {
+		key := fmt.Sprintf("%s:%d", r.ProtocolType.Code, r.LoadBalancerPort)
+		if managed[key] {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// diffLoadBalancerRules computes which rules need to be created and which
+// need to be removed to turn old into new. Rules are keyed by protocol:port,
+// but the API only supports add/remove, so a rule whose key is unchanged but
+// whose other fields differ (server_port, certificate_name, sni_certificate,
+// redirect, sticky_session, ...) is still removed and re-added rather than
+// left alone.
+func diffLoadBalancerRules(old, new []interface{}) (toAdd, toRemove []sdk.RequestLoadBalancerRule) {
+	oldRules := make(map[string]sdk.RequestLoadBalancerRule)
+	for _, v := range old {
+		rule := expandLoadBalancerRule(v.(map[string]interface{}))
+		oldRules[loadBalancerRuleKey(rule)] = rule
+	}
+
+	newRules := make(map[string]sdk.RequestLoadBalancerRule)
+	for _, v := range new {
+		rule := expandLoadBalancerRule(v.(map[string]interface{}))
+		newRules[loadBalancerRuleKey(rule)] = rule
+	}
+
+	for key, rule := range newRules {
+		oldRule, ok := oldRules[key]
+		if !ok || !reflect.DeepEqual(oldRule, rule) {
+			toAdd = append(toAdd, rule)
+		}
+	}
+
+	for key, rule := range oldRules {
+		newRule, ok := newRules[key]
+		if !ok || !reflect.DeepEqual(newRule, rule) {
+			toRemove = append(toRemove, rule)
+		}
+	}
+
+	return toAdd, toRemove
+}
+
+// reconcileLoadBalancerRules diffs the inline load_balancer_rule_list between
+// plan and state and issues the add/remove calls backing resource_ncloud_load_balancer_rule,
+// so inline rules and out-of-band ncloud_load_balancer_rule resources share one code path.
+func reconcileLoadBalancerRules(conn *sdk.Conn, lbNo string, old, new []interface{}) error {
+	toAdd, toRemove := diffLoadBalancerRules(old, new)
+
+	if len(toRemove) > 0 {
+		if err := deleteLoadBalancerRules(conn, lbNo, toRemove); err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		if err := createLoadBalancerRules(conn, lbNo, toAdd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) *sdk.RequestCreateLoadBalancerInstance {
 	lbRuleList := make([]sdk.RequestLoadBalancerRule, 0, len(d.Get("load_balancer_rule_list").([]interface{})))
 
@@ -270,8 +488,14 @@ func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) *sdk.RequestC
 				lbRule.L7HealthCheckPath = value.(string)
 			case "certificate_name":
 				lbRule.CertificateName = value.(string)
+			case "sni_certificate":
+				lbRule.SniCertificateList = expandSniCertificateList(value.([]interface{}))
 			case "proxy_protocol_use_yn":
 				lbRule.ProxyProtocolUseYn = value.(string)
+			case "redirect":
+				lbRule.Redirect = expandLoadBalancerRedirect(value.([]interface{}))
+			case "sticky_session":
+				lbRule.StickySession = expandStickySession(value.([]interface{}))
 			}
 		}
 		lbRuleList = append(lbRuleList, *lbRule)
@@ -309,7 +533,7 @@ func getLoadBalancerInstance(conn *sdk.Conn, LoadBalancerInstanceNo string) (*sd
 	return nil, nil
 }
 
-func deleteLoadBalancerInstance(conn *sdk.Conn, LoadBalancerInstanceNo string) error {
+func deleteLoadBalancerInstance(conn *sdk.Conn, LoadBalancerInstanceNo string, timeout time.Duration) error {
 	reqParams := &sdk.RequestDeleteLoadBalancerInstances{
 		LoadBalancerInstanceNoList: []string{LoadBalancerInstanceNo},
 	}
@@ -324,69 +548,42 @@ func deleteLoadBalancerInstance(conn *sdk.Conn, LoadBalancerInstanceNo string) e
 	}
 	logCommonResponse("DeleteLoadBalancerInstance", LoadBalancerInstanceNo, commonResponse)
 
-	return waitForDeleteLoadBalancerInstance(conn, LoadBalancerInstanceNo)
-}
-
-func waitForLoadBalancerInstance(conn *sdk.Conn, id string, status string, timeout time.Duration) error {
-	c1 := make(chan error, 1)
-
-	go func() {
-		for {
-			instance, err := getLoadBalancerInstance(conn, id)
-
-			if err != nil {
-				c1 <- err
-				return
-			}
-
-			if instance == nil || instance.LoadBalancerInstanceStatus.Code == status {
-				c1 <- nil
-				return
-			}
-
-			log.Printf("[DEBUG] Wait get load balancer instance [%s] status [%s] to be [%s]", id, instance.LoadBalancerInstanceStatus.Code, status)
-			time.Sleep(time.Second * 1)
-		}
-	}()
-
-	select {
-	case res := <-c1:
-		return res
-	case <-time.After(time.Second * timeout):
-		return fmt.Errorf("TIMEOUT : delete load balancer instance [%s] ", id)
+	stateConf := newStateChangeConf(
+		[]string{"USED", "BUSY"},
+		[]string{},
+		loadBalancerInstanceStateRefreshFunc(conn, LoadBalancerInstanceNo),
+		timeout,
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for load balancer instance (%s) to be deleted: %s", LoadBalancerInstanceNo, err)
 	}
-}
-
-func waitForDeleteLoadBalancerInstance(conn *sdk.Conn, id string) error {
-	c1 := make(chan error, 1)
-
-	go func() {
-		for {
-			instance, err := getLoadBalancerInstance(conn, id)
-
-			if err != nil {
-				c1 <- err
-				return
-			}
 
-			if instance == nil {
-				c1 <- nil
-				return
-			}
+	return nil
+}
 
-			log.Printf("[DEBUG] Wait delete load balancer instance [%s] ", id)
-			time.Sleep(time.Second * 1)
+// loadBalancerInstanceStateRefreshFunc implements resource.StateRefreshFunc
+// for the load balancer instance list API, returning the instance status code
+// ("" once the instance is no longer returned at all).
+func loadBalancerInstanceStateRefreshFunc(conn *sdk.Conn, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getLoadBalancerInstance(conn, id)
+		if err != nil {
+			return nil, "", err
 		}
-	}()
-
-	select {
-	case res := <-c1:
-		return res
-	case <-time.After(time.Second * DefaultTimeout):
-		return fmt.Errorf("TIMEOUT : delete load balancer instance [%s] ", id)
+		if instance == nil {
+			return nil, "", nil
+		}
+		return instance, instance.LoadBalancerInstanceStatus.Code, nil
 	}
 }
 
+// loadBalancerRuleSchemaResource's sni_certificate, redirect, and
+// sticky_session blocks round-trip through the SniCertificateList, Redirect,
+// and StickySession fields on sdk.LoadBalancerRule / sdk.RequestLoadBalancerRule
+// (see flatten/expand helpers above). This vendor surface is not part of the
+// current build environment's tree, so bump the vendored ncloud-sdk-go before
+// merging if those fields are not already present there — the classic
+// (non-autoscaling) load balancer API has not historically exposed them.
 var loadBalancerRuleSchemaResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"protocol_type_code": {
@@ -418,12 +615,94 @@ var loadBalancerRuleSchemaResource = &schema.Resource{
 		"certificate_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Load balancer SSL certificate. Required when the loadBalancerRuleList.N.protocloTypeCode value is SSL/HTTPS.",
+			Description: "Load balancer SSL certificate. Required when the loadBalancerRuleList.N.protocloTypeCode value is SSL/HTTPS and no sni_certificate blocks are given.",
+		},
+		"sni_certificate": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        sniCertificateSchemaResource,
+			Description: "Additional SSL certificates for this rule, one per SNI hostname. Only valid when protocol_type_code is SSL/HTTPS.",
 		},
 		"proxy_protocol_use_yn": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: "Use 'Y' if you want to check client IP addresses by enabling the proxy protocol while you select TCP or SSL.",
 		},
+		"redirect": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        loadBalancerRedirectSchemaResource,
+			Description: "Redirect requests on this rule to another protocol/port, typically used for HTTP to HTTPS redirection.",
+		},
+		"sticky_session": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        stickySessionSchemaResource,
+			Description: "Session persistence configuration for this rule.",
+		},
+	},
+}
+
+var sniCertificateSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"hostname": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "SNI hostname this certificate is served for.",
+		},
+		"certificate_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Load balancer SSL certificate served for the hostname above.",
+		},
+	},
+}
+
+var loadBalancerRedirectSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Whether requests on this rule are redirected instead of forwarded.",
+		},
+		"protocol_type_code": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateIncludeValues([]string{"HTTP", "HTTPS"}),
+			Description:  "Protocol to redirect to. Default: HTTPS.",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Port to redirect to. Default: 443.",
+		},
+		"status_code": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateIncludeIntValues([]int{301, 302}),
+			Description:  "HTTP status code used for the redirect response. Default: 301.",
+		},
+	},
+}
+
+var stickySessionSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Whether session persistence is enabled for this rule.",
+		},
+		"cookie_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the cookie used to track session affinity. Default: NCP_LB_STICKY.",
+		},
+		"expiration_period": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Cookie expiration period, in seconds.",
+		},
 	},
 }
@@ -0,0 +1,228 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go/sdk"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNcloudLoadBalancerRule manages a single load balancer rule out of
+// band from the load_balancer_rule_list attribute of resourceNcloudLoadBalancer.
+// The two are reconciled through the same createLoadBalancerRules /
+// deleteLoadBalancerRules helpers, so a load balancer can mix rules declared
+// inline with rules managed by this resource.
+func resourceNcloudLoadBalancerRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudLoadBalancerRuleCreate,
+		Read:   resourceNcloudLoadBalancerRuleRead,
+		Update: resourceNcloudLoadBalancerRuleUpdate,
+		Delete: resourceNcloudLoadBalancerRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the load balancer instance this rule belongs to.",
+			},
+			"protocol_type_code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Protocol type code of the load balancer rule. The following codes are available. [HTTP | HTTPS | TCP | SSL]",
+			},
+			"load_balancer_port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer port of the load balancer rule.",
+			},
+			"server_port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Server port of the load balancer rule.",
+			},
+			"l7_health_check_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Health check path of the load balancer rule. Required when protocol_type_code is HTTP/HTTPS.",
+			},
+			"certificate_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Load balancer SSL certificate. Required when protocol_type_code is SSL/HTTPS and no sni_certificate blocks are given.",
+			},
+			"sni_certificate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        sniCertificateSchemaResource,
+				Description: "Additional SSL certificates for this rule, one per SNI hostname. Only valid when protocol_type_code is SSL/HTTPS.",
+			},
+			"proxy_protocol_use_yn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Use 'Y' if you want to check client IP addresses by enabling the proxy protocol while you select TCP or SSL.",
+			},
+			"redirect": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        loadBalancerRedirectSchemaResource,
+				Description: "Redirect requests on this rule to another protocol/port, typically used for HTTP to HTTPS redirection.",
+			},
+			"sticky_session": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        stickySessionSchemaResource,
+				Description: "Session persistence configuration for this rule.",
+			},
+		},
+	}
+}
+
+func resourceNcloudLoadBalancerRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	lbNo := d.Get("load_balancer_instance_no").(string)
+	rule := ruleFromResourceData(d)
+
+	if err := createLoadBalancerRules(conn, lbNo, []sdk.RequestLoadBalancerRule{rule}); err != nil {
+		return err
+	}
+
+	d.SetId(loadBalancerRuleID(lbNo, rule))
+
+	return resourceNcloudLoadBalancerRuleRead(d, meta)
+}
+
+func resourceNcloudLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	lbNo := d.Get("load_balancer_instance_no").(string)
+	lb, err := getLoadBalancerInstance(conn, lbNo)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		d.SetId("")
+		return nil
+	}
+
+	rule := ruleFromResourceData(d)
+	for _, r := range lb.LoadBalancerRuleList {
+		if r.ProtocolType.Code == rule.ProtocolTypeCode && r.LoadBalancerPort == rule.LoadBalancerPort {
+			d.Set("server_port", r.ServerPort)
+			d.Set("l7_health_check_path", r.L7HealthCheckPath)
+			d.Set("certificate_name", r.CertificateName)
+			d.Set("sni_certificate", flattenSniCertificateList(r.SniCertificateList))
+			d.Set("proxy_protocol_use_yn", r.ProxyProtocolUseYn)
+			d.Set("redirect", flattenLoadBalancerRedirect(r.Redirect))
+			d.Set("sticky_session", flattenStickySession(r.StickySession))
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNcloudLoadBalancerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	lbNo := d.Get("load_balancer_instance_no").(string)
+	rule := ruleFromResourceData(d)
+
+	// The NCP API only exposes add/remove operations for load balancer rules,
+	// so an in-place attribute change is applied as a remove followed by an add.
+	if err := deleteLoadBalancerRules(conn, lbNo, []sdk.RequestLoadBalancerRule{rule}); err != nil {
+		return err
+	}
+	if err := createLoadBalancerRules(conn, lbNo, []sdk.RequestLoadBalancerRule{rule}); err != nil {
+		return err
+	}
+
+	return resourceNcloudLoadBalancerRuleRead(d, meta)
+}
+
+func resourceNcloudLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).conn
+
+	lbNo := d.Get("load_balancer_instance_no").(string)
+	rule := ruleFromResourceData(d)
+
+	return deleteLoadBalancerRules(conn, lbNo, []sdk.RequestLoadBalancerRule{rule})
+}
+
+func ruleFromResourceData(d *schema.ResourceData) sdk.RequestLoadBalancerRule {
+	return sdk.RequestLoadBalancerRule{
+		ProtocolTypeCode:   d.Get("protocol_type_code").(string),
+		LoadBalancerPort:   d.Get("load_balancer_port").(int),
+		ServerPort:         d.Get("server_port").(int),
+		L7HealthCheckPath:  d.Get("l7_health_check_path").(string),
+		CertificateName:    d.Get("certificate_name").(string),
+		SniCertificateList: expandSniCertificateList(d.Get("sni_certificate").([]interface{})),
+		ProxyProtocolUseYn: d.Get("proxy_protocol_use_yn").(string),
+		Redirect:           expandLoadBalancerRedirect(d.Get("redirect").([]interface{})),
+		StickySession:      expandStickySession(d.Get("sticky_session").([]interface{})),
+	}
+}
+
+func expandLoadBalancerRule(m map[string]interface{}) sdk.RequestLoadBalancerRule {
+	return sdk.RequestLoadBalancerRule{
+		ProtocolTypeCode:   m["protocol_type_code"].(string),
+		LoadBalancerPort:   m["load_balancer_port"].(int),
+		ServerPort:         m["server_port"].(int),
+		L7HealthCheckPath:  m["l7_health_check_path"].(string),
+		CertificateName:    m["certificate_name"].(string),
+		SniCertificateList: expandSniCertificateList(m["sni_certificate"].([]interface{})),
+		ProxyProtocolUseYn: m["proxy_protocol_use_yn"].(string),
+		Redirect:           expandLoadBalancerRedirect(m["redirect"].([]interface{})),
+		StickySession:      expandStickySession(m["sticky_session"].([]interface{})),
+	}
+}
+
+func loadBalancerRuleKey(rule sdk.RequestLoadBalancerRule) string {
+	return fmt.Sprintf("%s:%d", rule.ProtocolTypeCode, rule.LoadBalancerPort)
+}
+
+func loadBalancerRuleID(lbNo string, rule sdk.RequestLoadBalancerRule) string {
+	return strings.Join([]string{lbNo, loadBalancerRuleKey(rule)}, ":")
+}
+
+func createLoadBalancerRules(conn *sdk.Conn, lbNo string, rules []sdk.RequestLoadBalancerRule) error {
+	reqParams := &sdk.RequestCreateLoadBalancerRule{
+		LoadBalancerInstanceNo: lbNo,
+		LoadBalancerRuleList:   rules,
+	}
+
+	resp, err := conn.CreateLoadBalancerRule(reqParams)
+	if err != nil {
+		logErrorResponse("CreateLoadBalancerRule", err, reqParams)
+		return err
+	}
+	logCommonResponse("CreateLoadBalancerRule", reqParams, resp.CommonResponse)
+
+	return nil
+}
+
+func deleteLoadBalancerRules(conn *sdk.Conn, lbNo string, rules []sdk.RequestLoadBalancerRule) error {
+	reqParams := &sdk.RequestDeleteLoadBalancerRules{
+		LoadBalancerInstanceNo: lbNo,
+		LoadBalancerRuleList:   rules,
+	}
+
+	resp, err := conn.DeleteLoadBalancerRules(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteLoadBalancerRules", err, reqParams)
+		return err
+	}
+	logCommonResponse("DeleteLoadBalancerRules", reqParams, resp.CommonResponse)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ncloud
+
+import (
+	"testing"
+)
+
+func loadBalancerRuleMap(protocolTypeCode string, lbPort, serverPort int, certificateName string) map[string]interface{} {
+	return map[string]interface{}{
+		"protocol_type_code":    protocolTypeCode,
+		"load_balancer_port":    lbPort,
+		"server_port":           serverPort,
+		"l7_health_check_path":  "",
+		"certificate_name":      certificateName,
+		"sni_certificate":       []interface{}{},
+		"proxy_protocol_use_yn": "",
+		"redirect":              []interface{}{},
+		"sticky_session":        []interface{}{},
+	}
+}
+
+func TestDiffLoadBalancerRulesAddAndRemove(t *testing.T) {
+	old := []interface{}{loadBalancerRuleMap("HTTP", 80, 8080, "")}
+	new := []interface{}{loadBalancerRuleMap("HTTPS", 443, 8443, "cert-a")}
+
+	toAdd, toRemove := diffLoadBalancerRules(old, new)
+
+	if len(toAdd) != 1 || toAdd[0].ProtocolTypeCode != "HTTPS" {
+		t.Fatalf("expected the new HTTPS rule to be added, got %#v", toAdd)
+	}
+	if len(toRemove) != 1 || toRemove[0].ProtocolTypeCode != "HTTP" {
+		t.Fatalf("expected the old HTTP rule to be removed, got %#v", toRemove)
+	}
+}
+
+func TestDiffLoadBalancerRulesUnchanged(t *testing.T) {
+	rule := loadBalancerRuleMap("HTTP", 80, 8080, "")
+	old := []interface{}{rule}
+	new := []interface{}{loadBalancerRuleMap("HTTP", 80, 8080, "")}
+
+	toAdd, toRemove := diffLoadBalancerRules(old, new)
+
+	if len(toAdd) != 0 || len(toRemove) != 0 {
+		t.Fatalf("expected no changes for an identical rule, got toAdd=%#v toRemove=%#v", toAdd, toRemove)
+	}
+}
+
+// A rule can keep its protocol:port key while some other field (here,
+// certificate_name) changes. The diff must still remove and re-add it,
+// since the API only exposes add/remove, not an in-place update.
+func TestDiffLoadBalancerRulesSameKeyDifferentContent(t *testing.T) {
+	old := []interface{}{loadBalancerRuleMap("HTTPS", 443, 8443, "cert-a")}
+	new := []interface{}{loadBalancerRuleMap("HTTPS", 443, 8443, "cert-b")}
+
+	toAdd, toRemove := diffLoadBalancerRules(old, new)
+
+	if len(toAdd) != 1 || toAdd[0].CertificateName != "cert-b" {
+		t.Fatalf("expected the changed rule to be re-added with the new certificate, got %#v", toAdd)
+	}
+	if len(toRemove) != 1 || toRemove[0].CertificateName != "cert-a" {
+		t.Fatalf("expected the stale rule to be removed, got %#v", toRemove)
+	}
+}
@@ -0,0 +1,276 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudNasVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudNasVolumeCreate,
+		Read:   resourceNcloudNasVolumeRead,
+		Update: resourceNcloudNasVolumeUpdate,
+		Delete: resourceNcloudNasVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(DefaultTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"volume_name_postfix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Suffix appended to the NAS volume name, which ncloud prefixes with the account's standard NAS domain.",
+			},
+			"volume_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Size of the NAS volume, in GB. Size can only be increased after creation, never decreased.",
+			},
+			"volume_allotment_protocol_type_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIncludeValues([]string{"NFS", "CIFS"}),
+				Description:  "Protocol used to mount the NAS volume. NFS or CIFS.",
+			},
+			"server_instance_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of server instance numbers the NAS volume is attached to.",
+			},
+			"custom_ip_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of additional IPs allowed to mount the NAS volume.",
+			},
+			"nas_volume_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the NAS volume.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Zone the NAS volume is created in. Get available values using the getZoneList action.",
+			},
+			"nas_volume_instance_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nas_volume_instance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mount_information": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudNasVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).serverconn
+
+	reqParams := &server.CreateNasVolumeInstanceRequest{
+		VolumeNamePostfix:               ncloudString(d.Get("volume_name_postfix").(string)),
+		VolumeSize:                      ncloudInt32(d.Get("volume_size").(int)),
+		VolumeAllotmentProtocolTypeCode: ncloudString(d.Get("volume_allotment_protocol_type_code").(string)),
+		ServerInstanceNoList:            StringList(d.Get("server_instance_no_list").([]interface{})),
+		CustomIpList:                    StringList(d.Get("custom_ip_list").([]interface{})),
+		NasVolumeDescription:            ncloudString(d.Get("nas_volume_description").(string)),
+		ZoneNo:                          ncloudString(d.Get("zone").(string)),
+	}
+
+	resp, err := conn.V2Api.CreateNasVolumeInstanceUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("CreateNasVolumeInstance", err, reqParams)
+		return err
+	}
+	logCommonResponse("CreateNasVolumeInstance", reqParams, resp)
+
+	volume := resp.NasVolumeInstanceList[0]
+	d.SetId(*volume.NasVolumeInstanceNo)
+
+	stateConf := newStateChangeConf(
+		[]string{"INIT", "CREATING"},
+		[]string{"CREAT"},
+		nasVolumeInstanceStateRefreshFunc(conn, d.Id()),
+		d.Timeout(schema.TimeoutCreate),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for NAS volume (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceNcloudNasVolumeRead(d, meta)
+}
+
+func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).serverconn
+
+	volume, err := getNasVolumeInstance(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if volume == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("volume_size", ncloudInt32Value(volume.VolumeSize))
+	d.Set("volume_allotment_protocol_type_code", volume.VolumeAllotmentProtocolTypeCode)
+	d.Set("server_instance_no_list", volume.ServerInstanceNoList)
+	d.Set("custom_ip_list", volume.CustomIpList)
+	d.Set("nas_volume_description", volume.NasVolumeDescription)
+	d.Set("nas_volume_instance_status", volume.NasVolumeInstanceStatus)
+	d.Set("mount_information", volume.MountInformation)
+
+	return nil
+}
+
+func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).serverconn
+
+	if !d.HasChange("volume_size") {
+		return resourceNcloudNasVolumeRead(d, meta)
+	}
+
+	o, n := d.GetChange("volume_size")
+	oldSize, newSize := o.(int), n.(int)
+	if newSize < oldSize {
+		return fmt.Errorf("volume_size can only be increased: NAS volume (%s) is %d GB, requested %d GB", d.Id(), oldSize, newSize)
+	}
+
+	reqParams := &server.ChangeNasVolumeSizeRequest{
+		NasVolumeInstanceNo: ncloudString(d.Id()),
+		VolumeSize:          ncloudInt32(newSize),
+	}
+
+	resp, err := conn.V2Api.ChangeNasVolumeSizeUsingPUT(reqParams)
+	if err != nil {
+		logErrorResponse("ChangeNasVolumeSize", err, reqParams)
+		if isQuotaExceededError(err) {
+			// Roll the config back to the size NCP actually has, rather than
+			// leaving the state file pointing at a size change that never applied.
+			d.Set("volume_size", oldSize)
+		}
+		return err
+	}
+	logCommonResponse("ChangeNasVolumeSize", reqParams, resp)
+
+	stateConf := newStateChangeConf(
+		[]string{"CHANG"},
+		[]string{"CREAT"},
+		nasVolumeSizeStateRefreshFunc(conn, d.Id(), newSize),
+		d.Timeout(schema.TimeoutUpdate),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for NAS volume (%s) resize to %d GB: %s", d.Id(), newSize, err)
+	}
+
+	return resourceNcloudNasVolumeRead(d, meta)
+}
+
+func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NcloudSdk).serverconn
+
+	reqParams := &server.DeleteNasVolumeInstanceRequest{
+		NasVolumeInstanceNo: ncloudString(d.Id()),
+	}
+	_, err := conn.V2Api.DeleteNasVolumeInstanceUsingPOST(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteNasVolumeInstance", err, reqParams)
+		return err
+	}
+
+	stateConf := newStateChangeConf(
+		[]string{"CREAT", "CHANG"},
+		[]string{},
+		nasVolumeInstanceStateRefreshFunc(conn, d.Id()),
+		d.Timeout(schema.TimeoutDelete),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for NAS volume (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getNasVolumeInstance(conn *server.APIClient, id string) (*server.NasVolumeInstance, error) {
+	reqParams := &server.GetNasVolumeInstanceListRequest{
+		NasVolumeInstanceNoList: []string{id},
+	}
+	resp, err := conn.V2Api.GetNasVolumeInstanceListUsingGET(reqParams)
+	if err != nil {
+		logErrorResponse("GetNasVolumeInstanceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetNasVolumeInstanceList", reqParams, resp)
+
+	for _, volume := range resp.NasVolumeInstanceList {
+		if *volume.NasVolumeInstanceNo == id {
+			return &volume, nil
+		}
+	}
+	return nil, nil
+}
+
+// nasVolumeInstanceStateRefreshFunc implements resource.StateRefreshFunc for
+// the NAS volume instance list API, returning the instance status code ("" once
+// the volume is no longer returned at all).
+func nasVolumeInstanceStateRefreshFunc(conn *server.APIClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, err := getNasVolumeInstance(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if volume == nil {
+			return nil, "", nil
+		}
+		return volume, ncloudStringValue(volume.NasVolumeInstanceStatus), nil
+	}
+}
+
+// nasVolumeSizeStateRefreshFunc waits out a resize: it reports the normal
+// instance status once VolumeSize has actually caught up to target, and keeps
+// reporting "CHANG" (the pending resize state) otherwise.
+func nasVolumeSizeStateRefreshFunc(conn *server.APIClient, id string, target int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, err := getNasVolumeInstance(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if volume == nil {
+			return nil, "", nil
+		}
+		if ncloudInt32Value(volume.VolumeSize) != target {
+			return volume, "CHANG", nil
+		}
+		return volume, ncloudStringValue(volume.NasVolumeInstanceStatus), nil
+	}
+}
+
+// isQuotaExceededError reports whether the NCP API rejected a request because
+// of an account/zone quota limit, as opposed to a transient or validation error.
+func isQuotaExceededError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "exceed") || strings.Contains(msg, "quota")
+}
@@ -0,0 +1,72 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// validateIncludeIntValues returns a SchemaValidateFunc that rejects any
+// value not present in includes, mirroring validateIncludeValues for
+// schema.TypeInt fields.
+func validateIncludeIntValues(includes []int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		for _, include := range includes {
+			if value == include {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf("%q must be one of %v, got: %d", k, includes, value))
+		return
+	}
+}
+
+// ncloudString returns a pointer to the string value, or nil when the value
+// is empty. The autoscaling v2 SDK request/response structs use *string for
+// every field, while the schema.ResourceData accessors return plain values.
+func ncloudString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+// ncloudInt32 returns a pointer to the int32 value. Unlike ncloudString it
+// does not treat the zero value as "unset" — 0 is a meaningful value for
+// fields like min_size, so callers that need an absent-vs-zero distinction
+// should check d.GetOkExists before calling this.
+func ncloudInt32(v int) *int32 {
+	i := int32(v)
+	return &i
+}
+
+// ncloudFloat64 returns a pointer to the float64 value, for the same reason
+// as ncloudString.
+func ncloudFloat64(v float64) *float64 {
+	return &v
+}
+
+// ncloudStringValue safely dereferences a *string, returning "" for nil.
+func ncloudStringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// ncloudInt32Value safely dereferences an *int32, returning 0 for nil.
+func ncloudInt32Value(v *int32) int {
+	if v == nil {
+		return 0
+	}
+	return int(*v)
+}
+
+// ncloudFloat64Value safely dereferences a *float64, returning 0 for nil.
+func ncloudFloat64Value(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,43 @@
+package ncloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// defaultPollInterval is how often a waiter re-checks the resource state.
+	defaultPollInterval = 5 * time.Second
+	// defaultMinPollInterval floors the poll interval reported to helper/resource.
+	defaultMinPollInterval = 3 * time.Second
+	// defaultNotFoundChecks is the number of consecutive "not found" responses
+	// required before a delete waiter is considered done. NCP's list APIs can
+	// return an empty result for a resource that was just created, so a single
+	// not-found response right after Create must not be read as "already deleted".
+	defaultNotFoundChecks = 3
+)
+
+// newStateChangeConf builds a resource.StateChangeConf tuned for ncloud's
+// list-and-filter style APIs: pass the pending/target status codes and a
+// resource.StateRefreshFunc that looks the resource up and returns its status
+// code (or "" when the resource is gone). Use Target: []string{} for delete
+// waiters, since a nil resource with an empty Target is what tells
+// StateChangeConf the wait is satisfied.
+func newStateChangeConf(pending, target []string, refresh resource.StateRefreshFunc, timeout time.Duration) *resource.StateChangeConf {
+	conf := &resource.StateChangeConf{
+		Pending:        pending,
+		Target:         target,
+		Refresh:        refresh,
+		Timeout:        timeout,
+		PollInterval:   defaultPollInterval,
+		MinTimeout:     defaultMinPollInterval,
+		NotFoundChecks: defaultNotFoundChecks,
+	}
+	// StateChangeConf finishes an empty-Target wait on the first nil result;
+	// require defaultNotFoundChecks consecutive ones instead.
+	if len(target) == 0 {
+		conf.ContinuousTargetOccurence = defaultNotFoundChecks
+	}
+	return conf
+}
@@ -0,0 +1,48 @@
+package ncloud
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewStateChangeConf(t *testing.T) {
+	refresh := func() (interface{}, string, error) {
+		return nil, "USED", nil
+	}
+
+	conf := newStateChangeConf([]string{"INIT", "CREATING"}, []string{"USED"}, refresh, 10*time.Minute)
+
+	if len(conf.Pending) != 2 || conf.Pending[0] != "INIT" || conf.Pending[1] != "CREATING" {
+		t.Fatalf("expected pending codes to be passed through unchanged, got %#v", conf.Pending)
+	}
+	if len(conf.Target) != 1 || conf.Target[0] != "USED" {
+		t.Fatalf("expected target codes to be passed through unchanged, got %#v", conf.Target)
+	}
+	if conf.Timeout != 10*time.Minute {
+		t.Fatalf("expected the given timeout to be used as-is, got %s", conf.Timeout)
+	}
+	if conf.PollInterval != defaultPollInterval {
+		t.Fatalf("expected the default poll interval, got %s", conf.PollInterval)
+	}
+	if conf.MinTimeout != defaultMinPollInterval {
+		t.Fatalf("expected the default min poll interval, got %s", conf.MinTimeout)
+	}
+	if conf.NotFoundChecks != defaultNotFoundChecks {
+		t.Fatalf("expected the default not-found-checks count, got %d", conf.NotFoundChecks)
+	}
+	if conf.Refresh == nil {
+		t.Fatal("expected the refresh func to be set")
+	}
+}
+
+func TestNewStateChangeConfEmptyTargetForDelete(t *testing.T) {
+	refresh := func() (interface{}, string, error) {
+		return nil, "", nil
+	}
+
+	conf := newStateChangeConf([]string{"USED", "BUSY"}, []string{}, refresh, time.Minute)
+
+	if conf.Target == nil || len(conf.Target) != 0 {
+		t.Fatalf("expected an empty (non-nil) target slice for a delete waiter, got %#v", conf.Target)
+	}
+}